@@ -0,0 +1,229 @@
+// Package iotrainings читает экспорты с устройств (FIT/ANT+, GPX) и превращает их
+// в значения training.CaloriesCalculator, которые можно передать в training.ReadData
+// так же, как тренировки, заданные вручную.
+package iotrainings
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/tormoder/fit"
+
+	"github.com/Gorbachev007/go-first-floor-sprint-five/training"
+)
+
+// Profile описывает параметры пользователя, которых нет в самом FIT/GPX-файле,
+// но без которых нельзя посчитать калории: вес, рост и профиль пульсовых зон.
+type Profile struct {
+	training.Profile
+	Weight   float64 // вес пользователя, кг
+	HeightCm float64 // рост пользователя, см (нужен для Walking.Calories)
+}
+
+// ParseFIT разбирает FIT-файл (Garmin/ANT+) и возвращает срез тренировок,
+// пригодных для training.ReadData. Вес и профиль пульса берутся из profile,
+// т.к. сам FIT-файл не содержит массу тела пользователя.
+func ParseFIT(r io.Reader, profile Profile) ([]training.CaloriesCalculator, error) {
+	f, err := fit.Decode(r)
+	if err != nil {
+		return nil, fmt.Errorf("iotrainings: decode fit: %w", err)
+	}
+
+	activity, err := f.Activity()
+	if err != nil {
+		return nil, fmt.Errorf("iotrainings: fit file has no activity: %w", err)
+	}
+
+	var result []training.CaloriesCalculator
+	for _, session := range activity.Sessions {
+		duration := time.Duration(session.GetTotalTimerTimeScaled() * float64(time.Second))
+		base := training.Training{
+			Duration: duration,
+			Weight:   profile.Weight,
+			Profile:  profile.Profile,
+		}
+
+		if cadence := int(session.AvgCadence); cadence > 0 && duration > 0 {
+			// Приближенное количество шагов/гребков за тренировку по средней каденции.
+			base.Action = cadence * int(duration.Minutes())
+		}
+
+		result = append(result, buildFromSession(session, base, profile))
+	}
+
+	return result, nil
+}
+
+// buildFromSession определяет конкретный тип тренировки по типу спорта из FIT-сессии.
+// Для бега, ходьбы и прочих видов спорта с шагами сверяет дистанцию GPS с
+// Action*LenStep и оставляет большее из двух значений; для плавания и силовой
+// тренировки такой сверки нет.
+func buildFromSession(session *fit.SessionMsg, base training.Training, profile Profile) training.CaloriesCalculator {
+	gpsDistanceKm := session.GetTotalDistanceScaled() / training.MInKm // GetTotalDistanceScaled возвращает метры
+
+	switch session.Sport {
+	case fit.SportRunning:
+		base.TrainingType = "Бег"
+		base.LenStep = training.LenStep
+		reconcileDistance(&base, gpsDistanceKm)
+		return training.Running{Training: base}
+	case fit.SportWalking, fit.SportHiking:
+		base.TrainingType = "Ходьба"
+		base.LenStep = training.LenStep
+		reconcileDistance(&base, gpsDistanceKm)
+		return training.Walking{Training: base, Height: profile.HeightCm}
+	case fit.SportSwimming:
+		base.TrainingType = "Плавание"
+		base.LenStep = training.SwimmingLenStep
+		poolLength := int(session.GetPoolLengthScaled())
+		laps := int(session.NumActiveLengths)
+		return training.Swimming{Training: base, LengthPool: poolLength, CountPool: laps}
+	case fit.SportTraining:
+		base.TrainingType = "Силовая тренировка"
+		sets := int(session.NumLaps)
+		var reps int
+		if sets > 0 {
+			// FIT считает подход (Set) одним "кругом" (Lap), а повторения внутри
+			// него — циклами: средние повторения на подход — TotalCycles/NumLaps.
+			reps = int(session.TotalCycles) / sets
+		}
+		return training.Strength{
+			Training: base,
+			Sets:     sets,
+			Reps:     reps,
+			// Сводка по сессии FIT не хранит вес снаряда, поэтому приближаем его
+			// весом пользователя — типичное допущение для упражнений с весом тела.
+			WeightLifted: profile.Weight,
+		}
+	default:
+		base.TrainingType = session.Sport.String()
+		base.LenStep = training.LenStep
+		reconcileDistance(&base, gpsDistanceKm)
+		return training.Running{Training: base}
+	}
+}
+
+// reconcileDistance сверяет дистанцию, посчитанную по Action*LenStep, с дистанцией,
+// полученной по GPS, и оставляет в Action то значение, которое дает большую дистанцию.
+func reconcileDistance(t *training.Training, gpsDistanceKm float64) {
+	if t.LenStep <= 0 {
+		return
+	}
+
+	stepDistanceKm := float64(t.Action) * t.LenStep / training.MInKm
+	if gpsDistanceKm > stepDistanceKm {
+		t.Action = int(gpsDistanceKm * training.MInKm / t.LenStep)
+	}
+}
+
+// gpx описывает минимально необходимое подмножество формата GPX 1.1 с треками.
+type gpx struct {
+	Tracks []gpxTrack `xml:"trk"`
+}
+
+type gpxTrack struct {
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat        float64   `xml:"lat,attr"`
+	Lon        float64   `xml:"lon,attr"`
+	Time       time.Time `xml:"time"`
+	Extensions struct {
+		TrackPointExtension struct {
+			HR      int `xml:"hr"`
+			Cadence int `xml:"cad"`
+		} `xml:"TrackPointExtension"`
+	} `xml:"extensions"`
+}
+
+// ParseGPX разбирает GPX-трек и возвращает тренировки типа Running, построенные
+// по GPS-точкам: дистанция считается по формуле гаверсинусов, каденция точек,
+// если она присутствует, задает Action, а из двух дистанций (по шагам и по GPS)
+// остается большая.
+func ParseGPX(r io.Reader, profile Profile) ([]training.CaloriesCalculator, error) {
+	var doc gpx
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("iotrainings: decode gpx: %w", err)
+	}
+
+	var result []training.CaloriesCalculator
+	for _, track := range doc.Tracks {
+		for _, segment := range track.Segments {
+			if len(segment.Points) < 2 {
+				continue
+			}
+			result = append(result, buildFromSegment(segment, profile))
+		}
+	}
+
+	return result, nil
+}
+
+// buildFromSegment превращает один GPX-сегмент в тренировку Running, считая
+// дистанцию по координатам и, при наличии, пульс по точкам трека.
+func buildFromSegment(segment gpxSegment, profile Profile) training.CaloriesCalculator {
+	points := segment.Points
+	start := points[0].Time
+	duration := points[len(points)-1].Time.Sub(start)
+
+	var distanceKm float64
+	var cadenceSum, cadenceCount int
+	var samples []training.HeartRateSample
+
+	for i, p := range points {
+		if i > 0 {
+			distanceKm += haversineKm(points[i-1].Lat, points[i-1].Lon, p.Lat, p.Lon)
+		}
+		if cad := p.Extensions.TrackPointExtension.Cadence; cad > 0 {
+			cadenceSum += cad
+			cadenceCount++
+		}
+		if hr := p.Extensions.TrackPointExtension.HR; hr > 0 {
+			samples = append(samples, training.HeartRateSample{Offset: p.Time.Sub(start), BPM: hr})
+		}
+	}
+
+	t := training.Training{
+		TrainingType: "Бег",
+		LenStep:      training.LenStep,
+		Duration:     duration,
+		Weight:       profile.Weight,
+		Profile:      profile.Profile,
+		HeartRate:    samples,
+	}
+
+	if cadenceCount > 0 {
+		// Средняя каденция, умноженная на продолжительность тренировки в минутах,
+		// задает количество шагов; ниже reconcileDistance заменит его шагами по
+		// GPS-дистанции, если та окажется больше.
+		t.Action = (cadenceSum / cadenceCount) * int(duration.Minutes())
+	}
+	reconcileDistance(&t, distanceKm)
+
+	return training.Running{Training: t}
+}
+
+// earthRadiusKm радиус Земли, используемый при расчете дистанции по координатам.
+const earthRadiusKm = 6371.0
+
+// haversineKm возвращает расстояние между двумя точками на сфере по формуле гаверсинусов.
+func haversineKm(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return earthRadiusKm * c
+}
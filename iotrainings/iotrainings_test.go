@@ -0,0 +1,120 @@
+package iotrainings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tormoder/fit"
+
+	"github.com/Gorbachev007/go-first-floor-sprint-five/training"
+)
+
+func TestReconcileDistancePrefersGPSWhenLarger(t *testing.T) {
+	tr := &training.Training{LenStep: training.LenStep, Action: 100}
+	reconcileDistance(tr, 1) // 1 км по GPS > 100*0.65/1000 км по шагам
+
+	gpsDistanceKm := 1.0
+	want := int(gpsDistanceKm * training.MInKm / training.LenStep)
+	if tr.Action != want {
+		t.Errorf("Action = %d, want %d", tr.Action, want)
+	}
+}
+
+func TestReconcileDistanceKeepsStepsWhenGPSSmaller(t *testing.T) {
+	tr := &training.Training{LenStep: training.LenStep, Action: 10000}
+	reconcileDistance(tr, 0.1) // GPS-дистанция намного меньше, чем по шагам
+
+	if tr.Action != 10000 {
+		t.Errorf("Action = %d, want unchanged 10000", tr.Action)
+	}
+}
+
+func TestBuildFromSessionStrengthDerivesRepsAndWeight(t *testing.T) {
+	session := fit.NewSessionMsg()
+	session.Sport = fit.SportTraining
+	session.NumLaps = 3
+	session.TotalCycles = 30
+
+	profile := Profile{Weight: 80}
+	base := training.Training{Weight: profile.Weight}
+
+	got := buildFromSession(session, base, profile)
+	strength, ok := got.(training.Strength)
+	if !ok {
+		t.Fatalf("buildFromSession() = %T, want training.Strength", got)
+	}
+
+	if strength.Sets != 3 {
+		t.Errorf("Sets = %d, want 3", strength.Sets)
+	}
+	if strength.Reps != 10 {
+		t.Errorf("Reps = %d, want 10 (TotalCycles/NumLaps)", strength.Reps)
+	}
+	if strength.WeightLifted != profile.Weight {
+		t.Errorf("WeightLifted = %v, want %v (profile weight, as a stand-in for equipment weight)", strength.WeightLifted, profile.Weight)
+	}
+}
+
+func TestBuildFromSessionRunningReconcilesDistance(t *testing.T) {
+	session := fit.NewSessionMsg()
+	session.Sport = fit.SportRunning
+	session.TotalDistance = 5000 * 100 // GetTotalDistanceScaled делит на 100, итог 5 км
+
+	base := training.Training{}
+	got := buildFromSession(session, base, Profile{})
+	running, ok := got.(training.Running)
+	if !ok {
+		t.Fatalf("buildFromSession() = %T, want training.Running", got)
+	}
+
+	gpsDistanceKm := 5.0
+	wantAction := int(gpsDistanceKm * training.MInKm / training.LenStep)
+	if running.Action != wantAction {
+		t.Errorf("Action = %d, want %d", running.Action, wantAction)
+	}
+}
+
+func TestBuildFromSegmentUsesCadenceAndHeartRate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	// Точки в ~13 м друг от друга: GPS-дистанция меньше дистанции по каденции
+	// (85 шагов * 0.65 м), поэтому Action остается равным шагам по каденции.
+	segment := gpxSegment{
+		Points: []gpxPoint{
+			{Lat: 55.7558, Lon: 37.6173, Time: start},
+			{Lat: 55.7559, Lon: 37.6174, Time: start.Add(time.Minute)},
+		},
+	}
+	segment.Points[0].Extensions.TrackPointExtension.Cadence = 80
+	segment.Points[0].Extensions.TrackPointExtension.HR = 120
+	segment.Points[1].Extensions.TrackPointExtension.Cadence = 90
+	segment.Points[1].Extensions.TrackPointExtension.HR = 130
+
+	got := buildFromSegment(segment, Profile{Weight: 70})
+	running, ok := got.(training.Running)
+	if !ok {
+		t.Fatalf("buildFromSegment() = %T, want training.Running", got)
+	}
+
+	if len(running.HeartRate) != 2 {
+		t.Errorf("len(HeartRate) = %d, want 2", len(running.HeartRate))
+	}
+	const wantAction = (80 + 90) / 2 * 1 // средняя каденция * 1 минута
+	if running.Action != wantAction {
+		t.Errorf("Action = %d, want cadence-derived step count %d", running.Action, wantAction)
+	}
+}
+
+func TestHaversineKm(t *testing.T) {
+	// Москва (55.7558, 37.6173) — Санкт-Петербург (59.9311, 30.3609), примерно 635 км по прямой.
+	got := haversineKm(55.7558, 37.6173, 59.9311, 30.3609)
+	const want, tolerance = 635.0, 10.0
+	if got < want-tolerance || got > want+tolerance {
+		t.Errorf("haversineKm() = %.1f, want within %.0f km of %.0f", got, tolerance, want)
+	}
+}
+
+func TestHaversineKmSamePoint(t *testing.T) {
+	if got := haversineKm(55.7558, 37.6173, 55.7558, 37.6173); got != 0 {
+		t.Errorf("haversineKm() for identical points = %.4f, want 0", got)
+	}
+}
@@ -2,182 +2,17 @@ package main
 
 import (
 	"fmt"
-	"math"
 	"time"
-)
-
-// Общие константы для вычислений.
-const (
-	MInKm      = 1000 // количество метров в одном километре
-	MinInHours = 60   // количество минут в одном часе
-	LenStep    = 0.65 // длина одного шага
-	CmInM      = 100  // количество сантиметров в одном метре
-)
-
-// Training общая структура для всех тренировок
-type Training struct {
-	TrainingType string        // тип тренировки
-	Action       int           // количество повторов (шаги, гребки при плавании)
-	LenStep      float64       // длина одного шага или гребка
-	Duration     time.Duration // продолжительность тренировки
-	Weight       float64       // вес пользователя
-}
-
-// distance возвращает дистанцию, которую преодолел пользователь.
-func (t Training) distance() float64 {
-	return float64(t.Action) * t.LenStep / MInKm
-}
-
-// meanSpeed возвращает среднюю скорость движения во время тренировки.
-func (t Training) meanSpeed() float64 {
-	return t.distance() / (t.Duration.Hours())
-}
-
-// Calories возвращает количество потраченных килокалорий на тренировке (базовая реализация).
-func (t Training) Calories() float64 {
-	fmt.Println("using not reimplemented calories")
-	return 0 // Этот метод будет переопределен для каждого типа тренировки.
-}
-
-// InfoMessage содержит информацию о проведенной тренировке.
-type InfoMessage struct {
-	TrainingType string        // тип тренировки
-	Duration     time.Duration // длительность тренировки в минутах
-	Distance     float64       // расстояние в километрах
-	Speed        float64       // средняя скорость в км/ч
-	Calories     float64       // количество калорий
-}
-
-// TrainingInfo возвращает структуру InfoMessage с информацией о тренировке.
-func (t Training) TrainingInfo() InfoMessage {
-	return InfoMessage{
-		TrainingType: t.TrainingType,
-		Duration:     t.Duration,
-		Distance:     t.distance(),
-		Speed:        t.meanSpeed(),
-		Calories:     t.Calories(),
-	}
-}
-
-// String возвращает строку с информацией о проведенной тренировке.
-func (i InfoMessage) String() string {
-	return fmt.Sprintf("Тип тренировки: %s\nДлительность: %.2f мин\nДистанция: %.2f км\nСр. скорость: %.2f км/ч\nПотрачено ккал: %.2f\n",
-		i.TrainingType, i.Duration.Minutes(), i.Distance, i.Speed, i.Calories)
-}
-
-// CaloriesCalculator интерфейс для структур: Running, Walking и Swimming.
-type CaloriesCalculator interface {
-	Calories() float64
-	TrainingInfo() InfoMessage
-}
-
-// Running структура, описывающая тренировку Бег.
-type Running struct {
-	Training
-}
-
-// Константы для расчета потраченных килокалорий при беге.
-const (
-	CaloriesMeanSpeedMultiplier = 18   // множитель средней скорости бега
-	CaloriesMeanSpeedShift      = 1.79 // коэффициент изменения средней скорости
-)
-
-// Calories возвращает количество потраченных килокалорий при беге.
-func (r Running) Calories() float64 {
-	speed := r.meanSpeed()
-
-	fmt.Println()
-
-	return (CaloriesMeanSpeedMultiplier*speed + CaloriesMeanSpeedShift) * r.Weight / MInKm * r.Duration.Hours() * MinInHours
-}
-
-func (r Running) TrainingInfo() InfoMessage {
-	return InfoMessage{
-		TrainingType: r.TrainingType,
-		Duration:     r.Duration,
-		Distance:     r.distance(),
-		Speed:        r.meanSpeed(),
-		Calories:     r.Calories(), // Calls Running's own Calories method
-	}
-}
-
-// Walking структура, описывающая тренировку Ходьба.
-type Walking struct {
-	Training
-	Height float64 // рост пользователя в см
-}
-
-// Константы для расчета потраченных килокалорий при ходьбе.
-const (
-	CaloriesWeightMultiplier      = 0.035 // коэффициент для веса
-	CaloriesSpeedHeightMultiplier = 0.029 // коэффициент для роста
-	KmHInMsec                     = 0.278 // коэффициент для перевода км/ч в м/с
-)
-
-// Calories возвращает количество потраченных килокалорий при ходьбе.
-func (w Walking) Calories() float64 {
-	fmt.Println("using reimplemented")
-	speedInMps := w.meanSpeed() * KmHInMsec
-	return (CaloriesWeightMultiplier*w.Weight + (math.Pow(speedInMps, 2)/w.Height)*CaloriesSpeedHeightMultiplier*w.Weight) * w.Duration.Hours() * MinInHours
-}
-
-func (w Walking) TrainingInfo() InfoMessage {
-	return InfoMessage{
-		TrainingType: w.TrainingType,
-		Duration:     w.Duration,
-		Distance:     w.distance(),
-		Speed:        w.meanSpeed(),
-		Calories:     w.Calories(), // Calls Walking's own Calories method
-	}
-}
 
-// Swimming структура, описывающая тренировку Плавание.
-type Swimming struct {
-	Training
-	LengthPool int // длина бассейна в метрах
-	CountPool  int // количество пересечений бассейна
-}
-
-// Константы для расчета потраченных килокалорий при плавании.
-const (
-	SwimmingLenStep                  = 1.38 // длина одного гребка
-	SwimmingCaloriesMeanSpeedShift   = 1.1  // коэффициент изменения средней скорости
-	SwimmingCaloriesWeightMultiplier = 2    // множитель веса пользователя
+	"github.com/Gorbachev007/go-first-floor-sprint-five/training"
 )
 
-// meanSpeed возвращает среднюю скорость при плавании.
-func (s Swimming) meanSpeed() float64 {
-	return float64(s.LengthPool*s.CountPool) / MInKm / s.Duration.Hours()
-}
-
-// Calories возвращает количество калорий, потраченных при плавании.
-func (s Swimming) Calories() float64 {
-	speed := s.meanSpeed()
-	return (speed + SwimmingCaloriesMeanSpeedShift) * SwimmingCaloriesWeightMultiplier * s.Weight * s.Duration.Hours()
-}
-
-func (s Swimming) TrainingInfo() InfoMessage {
-	return InfoMessage{
-		TrainingType: s.TrainingType,
-		Duration:     s.Duration,
-		Distance:     s.distance(),
-		Speed:        s.meanSpeed(),
-		Calories:     s.Calories(), // Calls Swimming's own Calories method
-	}
-}
-
-// ReadData возвращает информацию о проведенной тренировке.
-func ReadData(training CaloriesCalculator) string {
-	info := training.TrainingInfo()
-	return fmt.Sprint(info)
-}
-
 func main() {
-	swimming := Swimming{
-		Training: Training{
+	swimming := training.Swimming{
+		Training: training.Training{
 			TrainingType: "Плавание",
 			Action:       2000,
-			LenStep:      SwimmingLenStep,
+			LenStep:      training.SwimmingLenStep,
 			Duration:     90 * time.Minute,
 			Weight:       85,
 		},
@@ -185,30 +20,93 @@ func main() {
 		CountPool:  5,
 	}
 
-	fmt.Println(ReadData(swimming))
+	fmt.Println(training.ReadData(swimming))
+
+	swimmingWithLaps := training.Swimming{
+		Training: training.Training{
+			TrainingType: "Плавание",
+			Duration:     40 * time.Minute,
+			Weight:       85,
+		},
+		LengthPool: 25,
+		CountPool:  20,
+		Laps: []training.SwimLap{
+			{Stroke: training.StrokeFreestyle, Duration: 25 * time.Second, Strokes: 18},
+			{Stroke: training.StrokeFreestyle, Duration: 26 * time.Second, Strokes: 19},
+			{Stroke: training.StrokeButterfly, Duration: 30 * time.Second, Strokes: 22},
+			{Stroke: training.StrokeBreaststroke, Duration: 35 * time.Second, Strokes: 16},
+		},
+	}
+
+	fmt.Println(training.ReadData(swimmingWithLaps))
 
-	walking := Walking{
-		Training: Training{
+	walking := training.Walking{
+		Training: training.Training{
 			TrainingType: "Ходьба",
 			Action:       20000,
-			LenStep:      LenStep,
+			LenStep:      training.LenStep,
 			Duration:     3*time.Hour + 45*time.Minute,
 			Weight:       85,
 		},
 		Height: 185,
 	}
 
-	fmt.Println(ReadData(walking))
+	fmt.Println(training.ReadData(walking))
+
+	running := training.Running{
+		Training: training.Training{
+			TrainingType: "Бег",
+			Action:       5000,
+			LenStep:      training.LenStep,
+			Duration:     30 * time.Minute,
+			Weight:       85,
+		},
+	}
+
+	fmt.Println(training.ReadData(running))
+	fmt.Println(training.ReadData(running, training.Imperial))
 
-	running := Running{
-		Training: Training{
+	runningWithHR := training.Running{
+		Training: training.Training{
 			TrainingType: "Бег",
 			Action:       5000,
-			LenStep:      LenStep,
+			LenStep:      training.LenStep,
 			Duration:     30 * time.Minute,
 			Weight:       85,
+			Profile:      training.Profile{Age: 30, RestingHR: 60, IsMale: true},
+			HeartRate: []training.HeartRateSample{
+				{Offset: 0, BPM: 120},
+				{Offset: 10 * time.Minute, BPM: 145},
+				{Offset: 20 * time.Minute, BPM: 160},
+				{Offset: 30 * time.Minute, BPM: 150},
+			},
 		},
 	}
 
-	fmt.Println(ReadData(running))
+	fmt.Println(training.ReadData(runningWithHR))
+
+	strength := training.Strength{
+		Training: training.Training{
+			TrainingType: "Силовая тренировка",
+			Duration:     45 * time.Minute,
+			Weight:       85,
+		},
+		ExerciseName:    "Жим лежа",
+		Sets:            4,
+		Reps:            8,
+		WeightLifted:    80,
+		RestBetweenSets: 90 * time.Second,
+	}
+
+	fmt.Println(training.ReadData(strength))
+
+	batch := []training.CaloriesCalculator{swimming, walking, running, strength}
+
+	if jsonBytes, err := training.Render(training.JSONRenderer{}, batch); err == nil {
+		fmt.Println(string(jsonBytes))
+	}
+
+	if csvBytes, err := training.Render(training.CSVRenderer{}, batch); err == nil {
+		fmt.Println(string(csvBytes))
+	}
 }
@@ -0,0 +1,92 @@
+package training
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// infoMessageJSON описывает JSON-представление InfoMessage: опциональные поля
+// (пульсовые зоны, силовая нагрузка) включаются только когда к тренировке применимы.
+type infoMessageJSON struct {
+	Type         string              `json:"type"`
+	DistanceKm   float64             `json:"distance_km"`
+	DurationMin  float64             `json:"duration_min"`
+	SpeedKmh     float64             `json:"speed_kmh"`
+	PaceSecPerKm *float64            `json:"pace_sec_per_km,omitempty"`
+	Calories     float64             `json:"calories"`
+	VolumeLoadKg *float64            `json:"volume_load_kg,omitempty"`
+	Sets         *int                `json:"sets,omitempty"`
+	Reps         *int                `json:"reps,omitempty"`
+	HeartRate    *[5]float64         `json:"heart_rate_zone_minutes,omitempty"`
+	Swimming     *swimmingDetailJSON `json:"swimming,omitempty"`
+}
+
+// swimmingDetailJSON JSON-представление SwimmingInfo.
+type swimmingDetailJSON struct {
+	AvgSWOLF        float64            `json:"avg_swolf"`
+	MasterStroke    Stroke             `json:"master_stroke"`
+	StrokeDistances map[Stroke]float64 `json:"stroke_distances_m"`
+}
+
+// MarshalJSON сериализует InfoMessage в JSON, включая опциональные поля
+// (силовую нагрузку, пульсовые зоны, детали плавания) только когда они были посчитаны.
+func (i InfoMessage) MarshalJSON() ([]byte, error) {
+	msg := infoMessageJSON{
+		Type:        i.TrainingType,
+		DistanceKm:  i.Distance,
+		DurationMin: i.Duration.Minutes(),
+		SpeedKmh:    i.Speed,
+		Calories:    i.Calories,
+	}
+
+	if i.Sets > 0 {
+		msg.VolumeLoadKg, msg.Sets, msg.Reps = &i.VolumeLoad, &i.Sets, &i.Reps
+	} else if pace, ok := secondsPerKm(i.Speed); ok {
+		msg.PaceSecPerKm = &pace
+	}
+	if i.HasHeartRateZones {
+		msg.HeartRate = &i.HeartRateZones
+	}
+	if i.SwimmingDetail != nil {
+		msg.Swimming = &swimmingDetailJSON{
+			AvgSWOLF:        i.SwimmingDetail.AvgSWOLF,
+			MasterStroke:    i.SwimmingDetail.MasterStroke,
+			StrokeDistances: i.SwimmingDetail.StrokeDistances,
+		}
+	}
+
+	return json.Marshal(msg)
+}
+
+// CSVHeader возвращает заголовок CSV, соответствующий порядку полей из CSVRecord.
+func CSVHeader() []string {
+	return []string{"type", "distance_km", "duration_min", "speed_kmh", "pace_sec_per_km", "calories", "volume_load_kg", "sets", "reps", "avg_swolf", "master_stroke"}
+}
+
+// CSVRecord возвращает InfoMessage в виде строки CSV в порядке полей CSVHeader.
+func (i InfoMessage) CSVRecord() []string {
+	record := []string{
+		i.TrainingType,
+		strconv.FormatFloat(i.Distance, 'f', 2, 64),
+		strconv.FormatFloat(i.Duration.Minutes(), 'f', 2, 64),
+		strconv.FormatFloat(i.Speed, 'f', 2, 64),
+		"",
+		strconv.FormatFloat(i.Calories, 'f', 2, 64),
+		"", "", "", "", "",
+	}
+
+	if i.Sets > 0 {
+		record[6] = strconv.FormatFloat(i.VolumeLoad, 'f', 1, 64)
+		record[7] = strconv.Itoa(i.Sets)
+		record[8] = strconv.Itoa(i.Reps)
+	} else if pace, ok := secondsPerKm(i.Speed); ok {
+		record[4] = strconv.FormatFloat(pace, 'f', 1, 64)
+	}
+
+	if i.SwimmingDetail != nil {
+		record[9] = strconv.FormatFloat(i.SwimmingDetail.AvgSWOLF, 'f', 1, 64)
+		record[10] = string(i.SwimmingDetail.MasterStroke)
+	}
+
+	return record
+}
@@ -0,0 +1,194 @@
+package training
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCSVHeaderRecordAlignment(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: "Бег",
+		Distance:     5,
+		Duration:     30 * time.Minute,
+		Speed:        10,
+		Calories:     300,
+	}
+
+	header := CSVHeader()
+	record := info.CSVRecord()
+	if len(header) != len(record) {
+		t.Fatalf("len(CSVHeader()) = %d, len(CSVRecord()) = %d, want equal", len(header), len(record))
+	}
+
+	indexOf := func(name string) int {
+		for i, h := range header {
+			if h == name {
+				return i
+			}
+		}
+		t.Fatalf("header %q not found in CSVHeader()", name)
+		return -1
+	}
+
+	if got := record[indexOf("type")]; got != "Бег" {
+		t.Errorf("record[type] = %q, want %q", got, "Бег")
+	}
+	if got := record[indexOf("distance_km")]; got != "5.00" {
+		t.Errorf("record[distance_km] = %q, want %q", got, "5.00")
+	}
+	if got := record[indexOf("pace_sec_per_km")]; got != "360.0" {
+		t.Errorf("record[pace_sec_per_km] = %q, want %q", got, "360.0")
+	}
+	if got := record[indexOf("sets")]; got != "" {
+		t.Errorf("record[sets] = %q, want empty for a distance-based training", got)
+	}
+}
+
+func TestCSVRecordStrength(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: "Силовая тренировка",
+		Calories:     250,
+		VolumeLoad:   800,
+		Sets:         4,
+		Reps:         10,
+	}
+
+	header := CSVHeader()
+	record := info.CSVRecord()
+
+	want := map[string]string{
+		"volume_load_kg":  "800.0",
+		"sets":            "4",
+		"reps":            "10",
+		"pace_sec_per_km": "",
+	}
+	for name, wantValue := range want {
+		idx := -1
+		for i, h := range header {
+			if h == name {
+				idx = i
+			}
+		}
+		if idx == -1 {
+			t.Fatalf("header %q not found", name)
+		}
+		if got := record[idx]; got != wantValue {
+			t.Errorf("record[%s] = %q, want %q", name, got, wantValue)
+		}
+	}
+}
+
+func TestCSVRecordSwimmingDetail(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: "Плавание",
+		SwimmingDetail: &SwimmingInfo{
+			AvgSWOLF:     42.5,
+			MasterStroke: StrokeFreestyle,
+		},
+	}
+
+	header := CSVHeader()
+	record := info.CSVRecord()
+
+	for i, h := range header {
+		switch h {
+		case "avg_swolf":
+			if record[i] != "42.5" {
+				t.Errorf("record[avg_swolf] = %q, want %q", record[i], "42.5")
+			}
+		case "master_stroke":
+			if record[i] != string(StrokeFreestyle) {
+				t.Errorf("record[master_stroke] = %q, want %q", record[i], StrokeFreestyle)
+			}
+		}
+	}
+}
+
+func TestMarshalJSONDistanceBasedOmitsStrengthFields(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: "Бег",
+		Distance:     5,
+		Duration:     30 * time.Minute,
+		Speed:        10,
+		Calories:     300,
+	}
+
+	b, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	for _, key := range []string{"sets", "reps", "volume_load_kg", "heart_rate_zone_minutes", "swimming"} {
+		if _, ok := got[key]; ok {
+			t.Errorf("JSON contains %q, want omitted for a distance-based training", key)
+		}
+	}
+	if _, ok := got["pace_sec_per_km"]; !ok {
+		t.Error("JSON missing pace_sec_per_km for a distance-based training")
+	}
+}
+
+func TestMarshalJSONStrengthOmitsPaceIncludesVolumeFields(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: "Силовая тренировка",
+		Calories:     250,
+		VolumeLoad:   800,
+		Sets:         4,
+		Reps:         10,
+	}
+
+	b, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if _, ok := got["pace_sec_per_km"]; ok {
+		t.Error("JSON contains pace_sec_per_km, want omitted for a strength training")
+	}
+	for _, key := range []string{"sets", "reps", "volume_load_kg"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("JSON missing %q for a strength training", key)
+		}
+	}
+}
+
+func TestMarshalJSONIncludesHeartRateZonesAndSwimmingDetail(t *testing.T) {
+	info := InfoMessage{
+		TrainingType:      "Плавание",
+		HasHeartRateZones: true,
+		HeartRateZones:    [5]float64{1, 2, 3, 4, 5},
+		SwimmingDetail: &SwimmingInfo{
+			AvgSWOLF:        42.5,
+			MasterStroke:    StrokeFreestyle,
+			StrokeDistances: map[Stroke]float64{StrokeFreestyle: 750},
+		},
+	}
+
+	b, err := json.Marshal(info)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if _, ok := got["heart_rate_zone_minutes"]; !ok {
+		t.Error("JSON missing heart_rate_zone_minutes when HasHeartRateZones is true")
+	}
+	if _, ok := got["swimming"]; !ok {
+		t.Error("JSON missing swimming when SwimmingDetail is set")
+	}
+}
@@ -0,0 +1,118 @@
+package training
+
+import "time"
+
+// HeartRateSample одно измерение пульса во время тренировки.
+type HeartRateSample struct {
+	Offset time.Duration // момент измерения от начала тренировки
+	BPM    int           // пульс, уд/мин
+}
+
+// Profile данные пользователя, необходимые для расчета пульсовых зон и калорий по формуле Кейтела.
+type Profile struct {
+	Age       int  // возраст, лет
+	RestingHR int  // пульс покоя, уд/мин
+	MaxHR     int  // максимальный пульс, уд/мин; если 0, считается как 220-Age
+	IsMale    bool // пол пользователя, используется формулой Кейтела
+}
+
+// maxHR возвращает максимальный пульс пользователя, используя MaxHR из профиля,
+// либо формулу "220 минус возраст", если MaxHR не задан.
+func (p Profile) maxHR() int {
+	if p.MaxHR > 0 {
+		return p.MaxHR
+	}
+	return 220 - p.Age
+}
+
+// Границы пульсовых зон в долях от HRmax.
+const (
+	HRZone1Min = 0.5 // зона 1: 50-60% HRmax
+	HRZone2Min = 0.6 // зона 2: 60-70% HRmax
+	HRZone3Min = 0.7 // зона 3: 70-80% HRmax
+	HRZone4Min = 0.8 // зона 4: 80-90% HRmax
+	HRZone5Min = 0.9 // зона 5: 90-100% HRmax
+)
+
+// HeartRateZoneCalculator распределяет показания пульса по пяти зонам интенсивности
+// и умеет считать калории по формуле Кейтела вместо формул, завязанных на скорость.
+type HeartRateZoneCalculator struct {
+	Samples []HeartRateSample
+	Profile Profile
+	Weight  float64 // вес пользователя, кг, нужен для формулы Кейтела
+}
+
+// ZoneMinutes возвращает количество минут, проведенных в каждой из пяти зон.
+// Индекс 0 соответствует зоне 1 (50-60% HRmax), индекс 4 - зоне 5 (90-100% HRmax).
+func (h HeartRateZoneCalculator) ZoneMinutes() [5]float64 {
+	var minutes [5]float64
+	if len(h.Samples) < 2 {
+		return minutes
+	}
+
+	hrMax := float64(h.Profile.maxHR())
+	for i := 1; i < len(h.Samples); i++ {
+		prev, cur := h.Samples[i-1], h.Samples[i]
+		fraction := float64(cur.BPM) / hrMax
+		if fraction < HRZone1Min {
+			continue // ниже зоны 1 (меньше 50% HRmax) — не входит ни в одну зону
+		}
+		minutes[zoneIndex(fraction)] += (cur.Offset - prev.Offset).Minutes()
+	}
+
+	return minutes
+}
+
+// zoneIndex возвращает индекс зоны (0-4) по доле HRmax.
+func zoneIndex(fraction float64) int {
+	switch {
+	case fraction >= HRZone5Min:
+		return 4
+	case fraction >= HRZone4Min:
+		return 3
+	case fraction >= HRZone3Min:
+		return 2
+	case fraction >= HRZone2Min:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Calories считает калории по формуле Кейтела, интегрируя мгновенный расход
+// по каждому интервалу между соседними показаниями пульса.
+func (h HeartRateZoneCalculator) Calories() float64 {
+	if len(h.Samples) < 2 {
+		return 0
+	}
+
+	var total float64
+	for i := 1; i < len(h.Samples); i++ {
+		prev, cur := h.Samples[i-1], h.Samples[i]
+		minutes := (cur.Offset - prev.Offset).Minutes()
+		total += keytelKcalPerMin(cur.BPM, h.Weight, h.Profile.Age, h.Profile.IsMale) * minutes
+	}
+
+	return total
+}
+
+// keytelKcalPerMin возвращает расход калорий в минуту по формуле Кейтела.
+func keytelKcalPerMin(bpm int, weight float64, age int, isMale bool) float64 {
+	hr, w, a := float64(bpm), weight, float64(age)
+	if isMale {
+		return (-55.0969 + 0.6309*hr + 0.1988*w + 0.2017*a) / 4.184
+	}
+	return (-20.4022 + 0.4472*hr - 0.1263*w + 0.074*a) / 4.184
+}
+
+// heartRateCalories пытается посчитать калории по пульсу, если для тренировки заданы
+// хотя бы два показания пульса. Возвращает ok=false, если показаний меньше двух;
+// заполненность профиля не проверяется.
+func heartRateCalories(samples []HeartRateSample, profile Profile, weight float64) (float64, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+
+	calc := HeartRateZoneCalculator{Samples: samples, Profile: profile, Weight: weight}
+	return calc.Calories(), true
+}
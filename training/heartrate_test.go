@@ -0,0 +1,51 @@
+package training
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestKeytelKcalPerMin(t *testing.T) {
+	// Справочное значение формулы Кейтела для мужчины при bpm=120, weight=80, age=30:
+	// (-55.0969 + 0.6309*120 + 0.1988*80 + 0.2017*30) / 4.184.
+	const want = 10.17337
+	if got := keytelKcalPerMin(120, 80, 30, true); math.Abs(got-want) > 1e-3 {
+		t.Errorf("keytelKcalPerMin(male) = %v, want ~%v", got, want)
+	}
+}
+
+func TestHeartRateZoneCalculatorIntegratesAcrossSamples(t *testing.T) {
+	profile := Profile{Age: 30, IsMale: true}
+	calc := HeartRateZoneCalculator{
+		Profile: profile,
+		Weight:  80,
+		Samples: []HeartRateSample{
+			{Offset: 0, BPM: 120},
+			{Offset: time.Minute, BPM: 150},
+			{Offset: 2 * time.Minute, BPM: 170},
+		},
+	}
+
+	zones := calc.ZoneMinutes()
+	var totalMinutes float64
+	for _, m := range zones {
+		totalMinutes += m
+	}
+	if math.Abs(totalMinutes-2) > 1e-9 {
+		t.Errorf("total zone minutes = %v, want 2 (one minute per interval)", totalMinutes)
+	}
+
+	if got := calc.Calories(); got <= 0 {
+		t.Errorf("Calories() = %v, want > 0", got)
+	}
+}
+
+func TestHeartRateCaloriesRequiresTwoSamples(t *testing.T) {
+	if _, ok := heartRateCalories(nil, Profile{}, 80); ok {
+		t.Error("heartRateCalories() with no samples, want ok=false")
+	}
+	if _, ok := heartRateCalories([]HeartRateSample{{BPM: 100}}, Profile{}, 80); ok {
+		t.Error("heartRateCalories() with one sample, want ok=false")
+	}
+}
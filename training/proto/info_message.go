@@ -0,0 +1,294 @@
+// Package proto содержит wire-совместимое представление info_message.proto.
+//
+// В окружении сборки нет доступного protoc, поэтому Marshal/Unmarshal написаны
+// вручную поверх google.golang.org/protobuf/encoding/protowire — это тот же
+// бинарный формат, что выдал бы protoc-gen-go, но без генератора: структуры и
+// методы ниже не генерируются и их можно редактировать.
+package proto
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// InfoMessage протобаф-представление тренировки по схеме info_message.proto.
+type InfoMessage struct {
+	Type                 string
+	DistanceKm           float64
+	DurationMin          float64
+	SpeedKmh             float64
+	Calories             float64
+	VolumeLoadKg         float64
+	Sets                 int32
+	Reps                 int32
+	HasHeartRateZones    bool
+	HeartRateZoneMinutes []float64
+	PaceSecPerKm         float64
+	SwimmingDetail       *SwimmingDetail
+}
+
+// SwimmingDetail разбивка по стилям плавания и SWOLF, см. training.SwimmingInfo.
+type SwimmingDetail struct {
+	AvgSWOLF        float64
+	MasterStroke    string
+	StrokeDistances map[string]float64
+}
+
+// Номера полей InfoMessage, как описано в info_message.proto.
+const (
+	fieldType                 = 1
+	fieldDistanceKm           = 2
+	fieldDurationMin          = 3
+	fieldSpeedKmh             = 4
+	fieldCalories             = 5
+	fieldVolumeLoadKg         = 6
+	fieldSets                 = 7
+	fieldReps                 = 8
+	fieldHasHeartRateZones    = 9
+	fieldHeartRateZoneMinutes = 10
+	fieldPaceSecPerKm         = 11
+	fieldSwimmingDetail       = 12
+)
+
+// Номера полей SwimmingDetail и его вложенного map-entry stroke_distances_m.
+const (
+	fieldAvgSWOLF         = 1
+	fieldMasterStroke     = 2
+	fieldStrokeDistancesM = 3
+	mapEntryFieldKey      = 1
+	mapEntryFieldValueM   = 2
+)
+
+// doubleBits и bitsDouble переводят float64 в/из fixed64 по правилам кодирования
+// protobuf double (IEEE 754, little-endian внутри AppendFixed64/ConsumeFixed64).
+func doubleBits(v float64) uint64 { return math.Float64bits(v) }
+
+func bitsDouble(v uint64) float64 { return math.Float64frombits(v) }
+
+// Marshal кодирует InfoMessage в протобаф-совместимые байты.
+func (m *InfoMessage) Marshal() []byte {
+	var b []byte
+	if m.Type != "" {
+		b = protowire.AppendTag(b, fieldType, protowire.BytesType)
+		b = protowire.AppendString(b, m.Type)
+	}
+	if m.DistanceKm != 0 {
+		b = protowire.AppendTag(b, fieldDistanceKm, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(m.DistanceKm))
+	}
+	if m.DurationMin != 0 {
+		b = protowire.AppendTag(b, fieldDurationMin, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(m.DurationMin))
+	}
+	if m.SpeedKmh != 0 {
+		b = protowire.AppendTag(b, fieldSpeedKmh, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(m.SpeedKmh))
+	}
+	if m.Calories != 0 {
+		b = protowire.AppendTag(b, fieldCalories, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(m.Calories))
+	}
+	if m.VolumeLoadKg != 0 {
+		b = protowire.AppendTag(b, fieldVolumeLoadKg, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(m.VolumeLoadKg))
+	}
+	if m.Sets != 0 {
+		b = protowire.AppendTag(b, fieldSets, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(m.Sets))
+	}
+	if m.Reps != 0 {
+		b = protowire.AppendTag(b, fieldReps, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(m.Reps))
+	}
+	if m.HasHeartRateZones {
+		b = protowire.AppendTag(b, fieldHasHeartRateZones, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(true))
+	}
+	if len(m.HeartRateZoneMinutes) > 0 {
+		var packed []byte
+		for _, v := range m.HeartRateZoneMinutes {
+			packed = protowire.AppendFixed64(packed, doubleBits(v))
+		}
+		b = protowire.AppendTag(b, fieldHeartRateZoneMinutes, protowire.BytesType)
+		b = protowire.AppendBytes(b, packed)
+	}
+	if m.PaceSecPerKm != 0 {
+		b = protowire.AppendTag(b, fieldPaceSecPerKm, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(m.PaceSecPerKm))
+	}
+	if m.SwimmingDetail != nil {
+		b = protowire.AppendTag(b, fieldSwimmingDetail, protowire.BytesType)
+		b = protowire.AppendBytes(b, m.SwimmingDetail.marshal())
+	}
+	return b
+}
+
+func (s *SwimmingDetail) marshal() []byte {
+	var b []byte
+	if s.AvgSWOLF != 0 {
+		b = protowire.AppendTag(b, fieldAvgSWOLF, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, doubleBits(s.AvgSWOLF))
+	}
+	if s.MasterStroke != "" {
+		b = protowire.AppendTag(b, fieldMasterStroke, protowire.BytesType)
+		b = protowire.AppendString(b, s.MasterStroke)
+	}
+
+	// Ключи сортируются, чтобы байты сообщения были детерминированы между вызовами,
+	// несмотря на произвольный порядок обхода map в Go.
+	keys := make([]string, 0, len(s.StrokeDistances))
+	for k := range s.StrokeDistances {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		var entry []byte
+		entry = protowire.AppendTag(entry, mapEntryFieldKey, protowire.BytesType)
+		entry = protowire.AppendString(entry, k)
+		entry = protowire.AppendTag(entry, mapEntryFieldValueM, protowire.Fixed64Type)
+		entry = protowire.AppendFixed64(entry, doubleBits(s.StrokeDistances[k]))
+
+		b = protowire.AppendTag(b, fieldStrokeDistancesM, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+
+	return b
+}
+
+// Unmarshal разбирает байты, полученные от Marshal, обратно в InfoMessage.
+func Unmarshal(b []byte) (*InfoMessage, error) {
+	m := &InfoMessage{}
+	for len(b) > 0 {
+		num, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return nil, fmt.Errorf("proto: invalid InfoMessage encoding: %w", protowire.ParseError(tagLen))
+		}
+		b = b[tagLen:]
+
+		valLen := protowire.ConsumeFieldValue(num, typ, b)
+		if valLen < 0 {
+			return nil, fmt.Errorf("proto: invalid InfoMessage encoding: %w", protowire.ParseError(valLen))
+		}
+		val := b[:valLen]
+		b = b[valLen:]
+
+		var err error
+		switch num {
+		case fieldType:
+			s, _ := protowire.ConsumeString(val)
+			m.Type = s
+		case fieldDistanceKm:
+			v, _ := protowire.ConsumeFixed64(val)
+			m.DistanceKm = bitsDouble(v)
+		case fieldDurationMin:
+			v, _ := protowire.ConsumeFixed64(val)
+			m.DurationMin = bitsDouble(v)
+		case fieldSpeedKmh:
+			v, _ := protowire.ConsumeFixed64(val)
+			m.SpeedKmh = bitsDouble(v)
+		case fieldCalories:
+			v, _ := protowire.ConsumeFixed64(val)
+			m.Calories = bitsDouble(v)
+		case fieldVolumeLoadKg:
+			v, _ := protowire.ConsumeFixed64(val)
+			m.VolumeLoadKg = bitsDouble(v)
+		case fieldSets:
+			v, _ := protowire.ConsumeVarint(val)
+			m.Sets = int32(v)
+		case fieldReps:
+			v, _ := protowire.ConsumeVarint(val)
+			m.Reps = int32(v)
+		case fieldHasHeartRateZones:
+			v, _ := protowire.ConsumeVarint(val)
+			m.HasHeartRateZones = protowire.DecodeBool(v)
+		case fieldHeartRateZoneMinutes:
+			packed, _ := protowire.ConsumeBytes(val)
+			for len(packed) > 0 {
+				v, n := protowire.ConsumeFixed64(packed)
+				if n < 0 {
+					return nil, fmt.Errorf("proto: invalid heart_rate_zone_minutes: %w", protowire.ParseError(n))
+				}
+				m.HeartRateZoneMinutes = append(m.HeartRateZoneMinutes, bitsDouble(v))
+				packed = packed[n:]
+			}
+		case fieldPaceSecPerKm:
+			v, _ := protowire.ConsumeFixed64(val)
+			m.PaceSecPerKm = bitsDouble(v)
+		case fieldSwimmingDetail:
+			raw, _ := protowire.ConsumeBytes(val)
+			m.SwimmingDetail, err = unmarshalSwimmingDetail(raw)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func unmarshalSwimmingDetail(b []byte) (*SwimmingDetail, error) {
+	s := &SwimmingDetail{}
+	for len(b) > 0 {
+		num, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return nil, fmt.Errorf("proto: invalid SwimmingDetail encoding: %w", protowire.ParseError(tagLen))
+		}
+		b = b[tagLen:]
+
+		valLen := protowire.ConsumeFieldValue(num, typ, b)
+		if valLen < 0 {
+			return nil, fmt.Errorf("proto: invalid SwimmingDetail encoding: %w", protowire.ParseError(valLen))
+		}
+		val := b[:valLen]
+		b = b[valLen:]
+
+		switch num {
+		case fieldAvgSWOLF:
+			v, _ := protowire.ConsumeFixed64(val)
+			s.AvgSWOLF = bitsDouble(v)
+		case fieldMasterStroke:
+			v, _ := protowire.ConsumeString(val)
+			s.MasterStroke = v
+		case fieldStrokeDistancesM:
+			entry, _ := protowire.ConsumeBytes(val)
+			key, value, err := unmarshalStrokeDistanceEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			if s.StrokeDistances == nil {
+				s.StrokeDistances = make(map[string]float64)
+			}
+			s.StrokeDistances[key] = value
+		}
+	}
+	return s, nil
+}
+
+func unmarshalStrokeDistanceEntry(b []byte) (key string, value float64, err error) {
+	for len(b) > 0 {
+		num, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return "", 0, fmt.Errorf("proto: invalid map entry encoding: %w", protowire.ParseError(tagLen))
+		}
+		b = b[tagLen:]
+
+		valLen := protowire.ConsumeFieldValue(num, typ, b)
+		if valLen < 0 {
+			return "", 0, fmt.Errorf("proto: invalid map entry encoding: %w", protowire.ParseError(valLen))
+		}
+		val := b[:valLen]
+		b = b[valLen:]
+
+		switch num {
+		case mapEntryFieldKey:
+			key, _ = protowire.ConsumeString(val)
+		case mapEntryFieldValueM:
+			v, _ := protowire.ConsumeFixed64(val)
+			value = bitsDouble(v)
+		}
+	}
+	return key, value, nil
+}
@@ -0,0 +1,55 @@
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	in := &InfoMessage{
+		Type:                 "Плавание",
+		DistanceKm:           1.5,
+		DurationMin:          32.5,
+		SpeedKmh:             2.77,
+		Calories:             312.4,
+		HasHeartRateZones:    true,
+		HeartRateZoneMinutes: []float64{1, 2, 3, 4, 5},
+		PaceSecPerKm:         1300,
+		SwimmingDetail: &SwimmingDetail{
+			AvgSWOLF:     42.5,
+			MasterStroke: "freestyle",
+			StrokeDistances: map[string]float64{
+				"freestyle":    750,
+				"backstroke":   250,
+				"butterfly":    0,
+				"breaststroke": 0,
+			},
+		},
+	}
+
+	out, err := Unmarshal(in.Marshal())
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", out, in)
+	}
+}
+
+func TestMarshalUnmarshalStrengthRoundTrip(t *testing.T) {
+	in := &InfoMessage{
+		Type:         "Силовая тренировка",
+		VolumeLoadKg: 1200,
+		Sets:         4,
+		Reps:         12,
+		Calories:     250,
+	}
+
+	out, err := Unmarshal(in.Marshal())
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", out, in)
+	}
+}
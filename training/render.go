@@ -0,0 +1,185 @@
+package training
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	protopb "github.com/Gorbachev007/go-first-floor-sprint-five/training/proto"
+)
+
+// ToProto переводит InfoMessage в протобаф-сообщение по схеме info_message.proto.
+func (i InfoMessage) ToProto() *protopb.InfoMessage {
+	pace, _ := secondsPerKm(i.Speed)
+	p := &protopb.InfoMessage{
+		Type:              i.TrainingType,
+		DistanceKm:        i.Distance,
+		DurationMin:       i.Duration.Minutes(),
+		SpeedKmh:          i.Speed,
+		Calories:          i.Calories,
+		VolumeLoadKg:      i.VolumeLoad,
+		Sets:              int32(i.Sets),
+		Reps:              int32(i.Reps),
+		HasHeartRateZones: i.HasHeartRateZones,
+		PaceSecPerKm:      pace,
+	}
+	if i.HasHeartRateZones {
+		p.HeartRateZoneMinutes = append([]float64(nil), i.HeartRateZones[:]...)
+	}
+	if i.SwimmingDetail != nil {
+		p.SwimmingDetail = &protopb.SwimmingDetail{
+			AvgSWOLF:        i.SwimmingDetail.AvgSWOLF,
+			MasterStroke:    string(i.SwimmingDetail.MasterStroke),
+			StrokeDistances: strokeDistancesToProto(i.SwimmingDetail.StrokeDistances),
+		}
+	}
+	return p
+}
+
+// FromProto восстанавливает InfoMessage из протобаф-сообщения.
+func FromProto(p *protopb.InfoMessage) InfoMessage {
+	info := InfoMessage{
+		TrainingType:      p.Type,
+		Duration:          minutesToDuration(p.DurationMin),
+		Distance:          p.DistanceKm,
+		Speed:             p.SpeedKmh,
+		Calories:          p.Calories,
+		VolumeLoad:        p.VolumeLoadKg,
+		Sets:              int(p.Sets),
+		Reps:              int(p.Reps),
+		HasHeartRateZones: p.HasHeartRateZones,
+	}
+	copy(info.HeartRateZones[:], p.HeartRateZoneMinutes)
+	if p.SwimmingDetail != nil {
+		info.SwimmingDetail = &SwimmingInfo{
+			AvgSWOLF:        p.SwimmingDetail.AvgSWOLF,
+			MasterStroke:    Stroke(p.SwimmingDetail.MasterStroke),
+			StrokeDistances: strokeDistancesFromProto(p.SwimmingDetail.StrokeDistances),
+		}
+	}
+	return info
+}
+
+// secondsPerKm переводит скорость (км/ч) в темп (с/км), как он хранится в proto.
+// Для силовых тренировок (скорость 0) темп не имеет смысла и возвращается 0.
+func secondsPerKm(kmh float64) (sec float64, ok bool) {
+	if kmh <= 0 {
+		return 0, false
+	}
+	return 3600 / kmh, true
+}
+
+func strokeDistancesToProto(m map[Stroke]float64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]float64, len(m))
+	for stroke, distance := range m {
+		out[string(stroke)] = distance
+	}
+	return out
+}
+
+func strokeDistancesFromProto(m map[string]float64) map[Stroke]float64 {
+	if m == nil {
+		return nil
+	}
+	out := make(map[Stroke]float64, len(m))
+	for stroke, distance := range m {
+		out[Stroke(stroke)] = distance
+	}
+	return out
+}
+
+// Renderer сериализует пакет InfoMessage в конкретный формат вывода.
+type Renderer interface {
+	Render(messages []InfoMessage) ([]byte, error)
+}
+
+// TextRenderer выводит тренировки тем же человекочитаемым текстом, что и InfoMessage.String.
+// Если Units не задан, используется Metric.
+type TextRenderer struct {
+	Units Units
+}
+
+// Render реализует Renderer для TextRenderer.
+func (r TextRenderer) Render(messages []InfoMessage) ([]byte, error) {
+	units := r.Units
+	if units == nil {
+		units = Metric
+	}
+
+	var buf bytes.Buffer
+	for _, m := range messages {
+		buf.WriteString(m.FormatWithUnits(units))
+	}
+	return buf.Bytes(), nil
+}
+
+// JSONRenderer сериализует тренировки в JSON-массив.
+type JSONRenderer struct{}
+
+// Render реализует Renderer для JSONRenderer.
+func (JSONRenderer) Render(messages []InfoMessage) ([]byte, error) {
+	return json.Marshal(messages)
+}
+
+// CSVRenderer сериализует тренировки в CSV с заголовком CSVHeader.
+type CSVRenderer struct{}
+
+// Render реализует Renderer для CSVRenderer.
+func (CSVRenderer) Render(messages []InfoMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(CSVHeader()); err != nil {
+		return nil, fmt.Errorf("training: write csv header: %w", err)
+	}
+	for _, m := range messages {
+		if err := w.Write(m.CSVRecord()); err != nil {
+			return nil, fmt.Errorf("training: write csv record: %w", err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("training: flush csv: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ProtoRenderer сериализует тренировки как последовательность протобаф-сообщений
+// в бинарном wire-формате (см. training/proto), каждое предварено varint-длиной —
+// это позволяет читать поток обратно сообщение за сообщением без разделителей.
+type ProtoRenderer struct{}
+
+// Render реализует Renderer для ProtoRenderer.
+func (ProtoRenderer) Render(messages []InfoMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, m := range messages {
+		encoded := m.ToProto().Marshal()
+		buf.Write(protowire.AppendVarint(nil, uint64(len(encoded))))
+		buf.Write(encoded)
+	}
+	return buf.Bytes(), nil
+}
+
+// Render прогоняет пачку тренировок через renderer за один вызов — в отличие от
+// ReadData, который форматирует одну тренировку.
+func Render(renderer Renderer, trainings []CaloriesCalculator) ([]byte, error) {
+	messages := make([]InfoMessage, len(trainings))
+	for i, t := range trainings {
+		messages[i] = t.TrainingInfo()
+	}
+	return renderer.Render(messages)
+}
+
+// minutesToDuration переводит минуты (float64) обратно в time.Duration.
+func minutesToDuration(minutes float64) time.Duration {
+	return time.Duration(minutes * float64(time.Minute))
+}
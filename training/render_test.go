@@ -0,0 +1,204 @@
+package training
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	protopb "github.com/Gorbachev007/go-first-floor-sprint-five/training/proto"
+)
+
+func TestToProtoFromProtoRoundTrip(t *testing.T) {
+	in := InfoMessage{
+		TrainingType:      "Плавание",
+		Distance:          1.5,
+		Duration:          32*time.Minute + 30*time.Second,
+		Speed:             2.77,
+		Calories:          312.4,
+		HasHeartRateZones: true,
+		HeartRateZones:    [5]float64{1, 2, 3, 4, 5},
+		SwimmingDetail: &SwimmingInfo{
+			AvgSWOLF:        42.5,
+			MasterStroke:    StrokeFreestyle,
+			StrokeDistances: map[Stroke]float64{StrokeFreestyle: 750, StrokeBackstroke: 250},
+		},
+	}
+
+	out := FromProto(in.ToProto())
+
+	if out.TrainingType != in.TrainingType || out.Distance != in.Distance || out.Calories != in.Calories {
+		t.Fatalf("round trip mismatch: got %+v, want %+v", out, in)
+	}
+	if out.HeartRateZones != in.HeartRateZones || out.HasHeartRateZones != in.HasHeartRateZones {
+		t.Errorf("heart rate zones mismatch: got %+v, want %+v", out.HeartRateZones, in.HeartRateZones)
+	}
+	if out.SwimmingDetail == nil || out.SwimmingDetail.AvgSWOLF != in.SwimmingDetail.AvgSWOLF {
+		t.Errorf("SwimmingDetail mismatch: got %+v, want %+v", out.SwimmingDetail, in.SwimmingDetail)
+	}
+	for stroke, distance := range in.SwimmingDetail.StrokeDistances {
+		if out.SwimmingDetail.StrokeDistances[stroke] != distance {
+			t.Errorf("StrokeDistances[%s] = %v, want %v", stroke, out.SwimmingDetail.StrokeDistances[stroke], distance)
+		}
+	}
+}
+
+func TestToProtoFromProtoStrengthRoundTrip(t *testing.T) {
+	in := InfoMessage{
+		TrainingType: "Силовая тренировка",
+		VolumeLoad:   800,
+		Sets:         4,
+		Reps:         10,
+		Calories:     250,
+	}
+
+	out := FromProto(in.ToProto())
+	if out.VolumeLoad != in.VolumeLoad || out.Sets != in.Sets || out.Reps != in.Reps {
+		t.Errorf("round trip mismatch: got %+v, want %+v", out, in)
+	}
+}
+
+func TestTextRendererUsesProvidedUnits(t *testing.T) {
+	r := TextRenderer{Units: Imperial}
+	info := InfoMessage{TrainingType: "Бег", Distance: 10, Duration: time.Hour, Speed: 10, Calories: 500}
+
+	got, err := r.Render([]InfoMessage{info})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	// 10 км * 0.621371 = 6.21 ми; сама подстрока "ми" есть и в метрическом "мин".
+	if !strings.Contains(string(got), "Дистанция: 6.21 ми\n") {
+		t.Errorf("Render() = %q, want imperial units (ми)", got)
+	}
+}
+
+func TestTextRendererDefaultsToMetric(t *testing.T) {
+	r := TextRenderer{}
+	info := InfoMessage{TrainingType: "Бег", Distance: 10, Duration: time.Hour, Speed: 10, Calories: 500}
+
+	got, err := r.Render([]InfoMessage{info})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if !strings.Contains(string(got), "км") {
+		t.Errorf("Render() = %q, want metric units (км)", got)
+	}
+}
+
+func TestJSONRendererProducesArray(t *testing.T) {
+	infos := []InfoMessage{
+		{TrainingType: "Бег", Distance: 5, Calories: 300},
+		{TrainingType: "Ходьба", Distance: 3, Calories: 150},
+	}
+
+	got, err := JSONRenderer{}.Render(infos)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(decoded) != 2 {
+		t.Fatalf("len(decoded) = %d, want 2", len(decoded))
+	}
+}
+
+func TestCSVRendererWritesHeaderAndRows(t *testing.T) {
+	infos := []InfoMessage{
+		{TrainingType: "Бег", Distance: 5, Calories: 300},
+	}
+
+	got, err := CSVRenderer{}.Render(infos)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(got)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("len(rows) = %d, want 2 (header + 1 record)", len(rows))
+	}
+	if !equalStrings(rows[0], CSVHeader()) {
+		t.Errorf("rows[0] = %v, want %v", rows[0], CSVHeader())
+	}
+}
+
+func TestProtoRendererWritesLengthPrefixedMessages(t *testing.T) {
+	infos := []InfoMessage{
+		{TrainingType: "Бег", Distance: 5, Calories: 300},
+		{TrainingType: "Ходьба", Distance: 3, Calories: 150},
+	}
+
+	got, err := ProtoRenderer{}.Render(infos)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var decoded []*protopb.InfoMessage
+	for len(got) > 0 {
+		size, n := protowire.ConsumeVarint(got)
+		if n < 0 {
+			t.Fatalf("ConsumeVarint() error, remaining %d bytes", len(got))
+		}
+		got = got[n:]
+
+		msg, err := protopb.Unmarshal(got[:size])
+		if err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		decoded = append(decoded, msg)
+		got = got[size:]
+	}
+
+	if len(decoded) != len(infos) {
+		t.Fatalf("len(decoded) = %d, want %d", len(decoded), len(infos))
+	}
+	for i, msg := range decoded {
+		if msg.Type != infos[i].TrainingType {
+			t.Errorf("decoded[%d].Type = %q, want %q", i, msg.Type, infos[i].TrainingType)
+		}
+	}
+}
+
+func TestRenderCallsTrainingInfoForEachTraining(t *testing.T) {
+	trainings := []CaloriesCalculator{
+		Running{Training: Training{TrainingType: "Бег", Action: 5000, LenStep: LenStep, Duration: 30 * time.Minute, Weight: 80}},
+		Strength{Training: Training{TrainingType: "Силовая тренировка", Weight: 80, Duration: time.Hour}, Sets: 3, Reps: 10, WeightLifted: 20},
+	}
+
+	got, err := Render(JSONRenderer{}, trainings)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(decoded) != len(trainings) {
+		t.Fatalf("len(decoded) = %d, want %d", len(decoded), len(trainings))
+	}
+	if decoded[1]["sets"] == nil {
+		t.Error("decoded[1] missing sets, want Render to use each training's own TrainingInfo()")
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
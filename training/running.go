@@ -0,0 +1,36 @@
+package training
+
+// Running структура, описывающая тренировку Бег.
+type Running struct {
+	Training
+}
+
+// Константы для расчета потраченных килокалорий при беге.
+const (
+	CaloriesMeanSpeedMultiplier = 18   // множитель средней скорости бега
+	CaloriesMeanSpeedShift      = 1.79 // коэффициент изменения средней скорости
+)
+
+// Calories возвращает количество потраченных килокалорий при беге.
+// При наличии показаний пульса используется формула Кейтела вместо формулы по скорости.
+func (r Running) Calories() float64 {
+	if kcal, ok := heartRateCalories(r.HeartRate, r.Profile, r.Weight); ok {
+		return kcal
+	}
+
+	speed := r.meanSpeed()
+
+	return (CaloriesMeanSpeedMultiplier*speed + CaloriesMeanSpeedShift) * r.Weight / MInKm * r.Duration.Hours() * MinInHours
+}
+
+func (r Running) TrainingInfo() InfoMessage {
+	info := InfoMessage{
+		TrainingType: r.TrainingType,
+		Duration:     r.Duration,
+		Distance:     r.distance(),
+		Speed:        r.meanSpeed(),
+		Calories:     r.Calories(), // Calls Running's own Calories method
+	}
+	info.HeartRateZones, info.HasHeartRateZones = heartRateInfo(r.Training)
+	return info
+}
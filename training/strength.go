@@ -0,0 +1,73 @@
+package training
+
+import "time"
+
+// Strength структура, описывающая силовую тренировку (жим, присед, подтягивания и т.п.),
+// в которой нет дистанции, а нагрузка считается через объем поднятого веса.
+type Strength struct {
+	Training
+	ExerciseName    string        // название упражнения
+	Sets            int           // количество подходов
+	Reps            int           // количество повторений в подходе
+	WeightLifted    float64       // вес снаряда за одно повторение, кг
+	RestBetweenSets time.Duration // отдых между подходами
+}
+
+// Пороги объема нагрузки (Sets*Reps*WeightLifted, кг) и соответствующие им MET.
+const (
+	StrengthVolumeLightMax    = 500  // верхняя граница легкой нагрузки
+	StrengthVolumeModerateMax = 1500 // верхняя граница средней нагрузки
+
+	StrengthMETLight    = 3.5 // MET для легкой нагрузки
+	StrengthMETModerate = 5.0 // MET для средней нагрузки
+	StrengthMETVigorous = 6.0 // MET для высокой нагрузки
+)
+
+// distance у силовой тренировки отсутствует, т.к. нет перемещения на дистанцию.
+func (s Strength) distance() float64 {
+	return 0
+}
+
+// meanSpeed у силовой тренировки отсутствует по той же причине, что и distance.
+func (s Strength) meanSpeed() float64 {
+	return 0
+}
+
+// volumeLoad возвращает суммарный объем поднятого веса за тренировку.
+func (s Strength) volumeLoad() float64 {
+	return float64(s.Sets*s.Reps) * s.WeightLifted
+}
+
+// met возвращает метаболический эквивалент нагрузки в зависимости от объема.
+func (s Strength) met() float64 {
+	switch volume := s.volumeLoad(); {
+	case volume <= StrengthVolumeLightMax:
+		return StrengthMETLight
+	case volume <= StrengthVolumeModerateMax:
+		return StrengthMETModerate
+	default:
+		return StrengthMETVigorous
+	}
+}
+
+// Calories возвращает количество потраченных килокалорий на силовой тренировке
+// по MET-формуле: kcal = MET * вес тела * продолжительность в часах.
+func (s Strength) Calories() float64 {
+	return s.met() * s.Weight * s.Duration.Hours()
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о силовой тренировке.
+func (s Strength) TrainingInfo() InfoMessage {
+	info := InfoMessage{
+		TrainingType: s.TrainingType,
+		Duration:     s.Duration,
+		Distance:     s.distance(),
+		Speed:        s.meanSpeed(),
+		Calories:     s.Calories(),
+		VolumeLoad:   s.volumeLoad(),
+		Sets:         s.Sets,
+		Reps:         s.Reps,
+	}
+	info.HeartRateZones, info.HasHeartRateZones = heartRateInfo(s.Training)
+	return info
+}
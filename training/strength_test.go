@@ -0,0 +1,57 @@
+package training
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStrengthMETBuckets(t *testing.T) {
+	tests := []struct {
+		name   string
+		volume float64
+		want   float64
+	}{
+		{"light", StrengthVolumeLightMax, StrengthMETLight},
+		{"moderate lower bound", StrengthVolumeLightMax + 1, StrengthMETModerate},
+		{"moderate upper bound", StrengthVolumeModerateMax, StrengthMETModerate},
+		{"vigorous", StrengthVolumeModerateMax + 1, StrengthMETVigorous},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Strength{Sets: 1, Reps: 1, WeightLifted: tt.volume}
+			if got := s.met(); got != tt.want {
+				t.Errorf("met() for volume %.0f = %v, want %v", tt.volume, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrengthVolumeLoad(t *testing.T) {
+	s := Strength{Sets: 4, Reps: 10, WeightLifted: 20}
+	if got, want := s.volumeLoad(), 800.0; got != want {
+		t.Errorf("volumeLoad() = %v, want %v", got, want)
+	}
+}
+
+func TestStrengthTrainingInfoIncludesHeartRateZones(t *testing.T) {
+	s := Strength{
+		Training: Training{
+			Weight: 80,
+			HeartRate: []HeartRateSample{
+				{Offset: 0, BPM: 120},
+				{Offset: time.Minute, BPM: 150},
+			},
+			Profile: Profile{Age: 30},
+		},
+		Sets: 3, Reps: 10, WeightLifted: 20,
+	}
+
+	info := s.TrainingInfo()
+	if !info.HasHeartRateZones {
+		t.Fatal("HasHeartRateZones = false, want true when HeartRate is set")
+	}
+	if info.HeartRateZones == ([5]float64{}) {
+		t.Error("HeartRateZones is all-zero, want a non-empty zone breakdown")
+	}
+}
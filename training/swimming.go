@@ -0,0 +1,173 @@
+package training
+
+import "time"
+
+// Swimming структура, описывающая тренировку Плавание.
+type Swimming struct {
+	Training
+	LengthPool int // длина бассейна в метрах
+	CountPool  int // количество пересечений бассейна
+
+	Laps []SwimLap // отрезки по гребку (опционально, для подробной разбивки по стилям)
+}
+
+// Stroke стиль плавания на отдельном отрезке (длине бассейна).
+type Stroke string
+
+// Поддерживаемые стили плавания.
+const (
+	StrokeFreestyle    Stroke = "freestyle"
+	StrokeBreaststroke Stroke = "breaststroke"
+	StrokeBackstroke   Stroke = "backstroke"
+	StrokeButterfly    Stroke = "butterfly"
+)
+
+// SwimLap один отрезок (длина бассейна), проплытый определенным стилем.
+type SwimLap struct {
+	Stroke   Stroke        // стиль плавания на отрезке
+	Duration time.Duration // время отрезка
+	Strokes  int           // количество гребков на отрезке
+}
+
+// Константы для расчета потраченных килокалорий при плавании.
+const (
+	SwimmingLenStep                  = 1.38 // длина одного гребка
+	SwimmingCaloriesMeanSpeedShift   = 1.1  // коэффициент изменения средней скорости
+	SwimmingCaloriesWeightMultiplier = 2    // множитель веса пользователя (без разбивки по стилям)
+)
+
+// Множители веса пользователя по стилю плавания: чем энергозатратнее стиль, тем выше множитель.
+const (
+	StrokeCaloriesMultiplierButterfly    = 2.8
+	StrokeCaloriesMultiplierBreaststroke = 2.4
+	StrokeCaloriesMultiplierFreestyle    = 2.0
+	StrokeCaloriesMultiplierBackstroke   = 1.8
+)
+
+// strokeCaloriesMultiplier возвращает множитель веса пользователя для стиля плавания,
+// либо SwimmingCaloriesWeightMultiplier, если стиль не распознан.
+func strokeCaloriesMultiplier(stroke Stroke) float64 {
+	switch stroke {
+	case StrokeButterfly:
+		return StrokeCaloriesMultiplierButterfly
+	case StrokeBreaststroke:
+		return StrokeCaloriesMultiplierBreaststroke
+	case StrokeFreestyle:
+		return StrokeCaloriesMultiplierFreestyle
+	case StrokeBackstroke:
+		return StrokeCaloriesMultiplierBackstroke
+	default:
+		return SwimmingCaloriesWeightMultiplier
+	}
+}
+
+// distance возвращает дистанцию, которую проплыл пользователь. Если заданы Laps,
+// считает ее по LengthPool*len(Laps), т.к. в этом случае Action/LenStep не заполняются.
+func (s Swimming) distance() float64 {
+	if len(s.Laps) == 0 {
+		return float64(s.LengthPool*s.CountPool) / MInKm
+	}
+
+	return float64(s.LengthPool*len(s.Laps)) / MInKm
+}
+
+// meanSpeed возвращает среднюю скорость при плавании. Если заданы Laps, считает
+// средневзвешенную скорость по отрезкам (каждый отрезок — одна длина бассейна).
+func (s Swimming) meanSpeed() float64 {
+	if len(s.Laps) == 0 {
+		return s.distance() / s.Duration.Hours()
+	}
+
+	var totalDuration time.Duration
+	for _, lap := range s.Laps {
+		totalDuration += lap.Duration
+	}
+	if totalDuration == 0 {
+		return 0
+	}
+
+	return s.distance() / totalDuration.Hours()
+}
+
+// SwimmingInfo содержит посчитанную по Laps детализацию по стилям плавания.
+type SwimmingInfo struct {
+	AvgSWOLF        float64            // средний SWOLF, нормализованный на 25-метровый бассейн
+	MasterStroke    Stroke             // стиль, которым проплыта наибольшая дистанция
+	StrokeDistances map[Stroke]float64 // дистанция в метрах по каждому стилю
+}
+
+// swimmingDetail считает SWOLF и распределение дистанции по стилям, если заданы Laps.
+func (s Swimming) swimmingDetail() *SwimmingInfo {
+	if len(s.Laps) == 0 || s.LengthPool == 0 {
+		return nil
+	}
+
+	const referencePoolLenM = 25 // SWOLF принято нормировать на 25-метровый бассейн
+
+	var swolfSum float64
+	distances := make(map[Stroke]float64)
+	var strokeOrder []Stroke
+	for _, lap := range s.Laps {
+		swolf := (lap.Duration.Seconds() + float64(lap.Strokes)) * referencePoolLenM / float64(s.LengthPool)
+		swolfSum += swolf
+		if _, seen := distances[lap.Stroke]; !seen {
+			strokeOrder = append(strokeOrder, lap.Stroke)
+		}
+		distances[lap.Stroke] += float64(s.LengthPool)
+	}
+
+	// Обходим стили в порядке их первого появления в Laps, а не по map — порядок
+	// обхода map в Go не детерминирован, и при равной дистанции результат менялся бы
+	// от запуска к запуску.
+	masterStroke := strokeOrder[0]
+	masterDistance := distances[masterStroke]
+	for _, stroke := range strokeOrder[1:] {
+		if distance := distances[stroke]; distance > masterDistance {
+			masterStroke, masterDistance = stroke, distance
+		}
+	}
+
+	return &SwimmingInfo{
+		AvgSWOLF:        swolfSum / float64(len(s.Laps)),
+		MasterStroke:    masterStroke,
+		StrokeDistances: distances,
+	}
+}
+
+// Calories возвращает количество калорий, потраченных при плавании. При наличии
+// показаний пульса используется формула Кейтела. Если заданы Laps, у каждого стиля
+// плавания свой множитель веса вместо одного SwimmingCaloriesWeightMultiplier.
+func (s Swimming) Calories() float64 {
+	if kcal, ok := heartRateCalories(s.HeartRate, s.Profile, s.Weight); ok {
+		return kcal
+	}
+
+	if len(s.Laps) > 0 {
+		var total float64
+		for _, lap := range s.Laps {
+			if lap.Duration <= 0 {
+				continue
+			}
+			speed := float64(s.LengthPool) / MInKm / lap.Duration.Hours()
+			total += (speed + SwimmingCaloriesMeanSpeedShift) * strokeCaloriesMultiplier(lap.Stroke) * s.Weight * lap.Duration.Hours()
+		}
+		return total
+	}
+
+	speed := s.meanSpeed()
+	return (speed + SwimmingCaloriesMeanSpeedShift) * SwimmingCaloriesWeightMultiplier * s.Weight * s.Duration.Hours()
+}
+
+func (s Swimming) TrainingInfo() InfoMessage {
+	info := InfoMessage{
+		TrainingType: s.TrainingType,
+		Duration:     s.Duration,
+		Distance:     s.distance(),
+		Speed:        s.meanSpeed(),
+		Calories:     s.Calories(), // Calls Swimming's own Calories method
+		PoolLengthM:  float64(s.LengthPool),
+	}
+	info.HeartRateZones, info.HasHeartRateZones = heartRateInfo(s.Training)
+	info.SwimmingDetail = s.swimmingDetail()
+	return info
+}
@@ -0,0 +1,70 @@
+package training
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSwimmingDetailMasterStrokeTieBreak(t *testing.T) {
+	s := Swimming{
+		LengthPool: 25,
+		Laps: []SwimLap{
+			{Stroke: StrokeBackstroke, Duration: 20 * time.Second, Strokes: 10},
+			{Stroke: StrokeFreestyle, Duration: 18 * time.Second, Strokes: 12},
+		},
+	}
+
+	detail := s.swimmingDetail()
+	if detail == nil {
+		t.Fatal("swimmingDetail() = nil, want non-nil")
+	}
+
+	// При равной дистанции по стилям (по одному отрезку каждый) побеждает стиль
+	// первого отрезка — результат должен быть одинаковым при каждом запуске.
+	for i := 0; i < 20; i++ {
+		if got := s.swimmingDetail().MasterStroke; got != StrokeBackstroke {
+			t.Errorf("MasterStroke = %q, want %q (run %d)", got, StrokeBackstroke, i)
+		}
+	}
+}
+
+func TestSwimmingDetailAvgSWOLF(t *testing.T) {
+	s := Swimming{
+		LengthPool: 25,
+		Laps: []SwimLap{
+			{Stroke: StrokeFreestyle, Duration: 20 * time.Second, Strokes: 15},
+		},
+	}
+
+	detail := s.swimmingDetail()
+	if detail == nil {
+		t.Fatal("swimmingDetail() = nil, want non-nil")
+	}
+
+	const want = 35.0 // (20s + 15 гребков) * 25м / 25м
+	if detail.AvgSWOLF != want {
+		t.Errorf("AvgSWOLF = %.1f, want %.1f", detail.AvgSWOLF, want)
+	}
+}
+
+func TestSwimmingDistanceWithLapsMatchesSpeed(t *testing.T) {
+	// Action/LenStep не задаются, когда тренировка описана через Laps — distance()
+	// и meanSpeed() должны при этом согласованно опираться на LengthPool*len(Laps).
+	s := Swimming{
+		LengthPool: 25,
+		Laps: []SwimLap{
+			{Stroke: StrokeFreestyle, Duration: 20 * time.Second, Strokes: 15},
+			{Stroke: StrokeFreestyle, Duration: 20 * time.Second, Strokes: 15},
+		},
+	}
+
+	const wantKm = 0.05 // 2 отрезка по 25м
+	if got := s.distance(); got != wantKm {
+		t.Errorf("distance() = %v, want %v", got, wantKm)
+	}
+
+	wantSpeed := wantKm / (40 * time.Second).Hours()
+	if got := s.meanSpeed(); got != wantSpeed {
+		t.Errorf("meanSpeed() = %v, want %v", got, wantSpeed)
+	}
+}
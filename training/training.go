@@ -0,0 +1,150 @@
+// Package training содержит общую модель тренировок (бег, ходьба, плавание, силовые)
+// и расчет потраченных калорий и сводной информации по ним.
+package training
+
+import (
+	"fmt"
+	"time"
+)
+
+// Общие константы для вычислений.
+const (
+	MInKm      = 1000 // количество метров в одном километре
+	MinInHours = 60   // количество минут в одном часе
+	LenStep    = 0.65 // длина одного шага
+	CmInM      = 100  // количество сантиметров в одном метре
+)
+
+// Training общая структура для всех тренировок
+type Training struct {
+	TrainingType string        // тип тренировки
+	Action       int           // количество повторов (шаги, гребки при плавании)
+	LenStep      float64       // длина одного шага или гребка
+	Duration     time.Duration // продолжительность тренировки
+	Weight       float64       // вес пользователя
+
+	HeartRate []HeartRateSample // показания пульса во время тренировки (опционально)
+	Profile   Profile           // профиль пользователя, нужен для расчета пульсовых зон (опционально)
+}
+
+// distance возвращает дистанцию, которую преодолел пользователь.
+func (t Training) distance() float64 {
+	return float64(t.Action) * t.LenStep / MInKm
+}
+
+// meanSpeed возвращает среднюю скорость движения во время тренировки.
+func (t Training) meanSpeed() float64 {
+	return t.distance() / (t.Duration.Hours())
+}
+
+// Calories возвращает количество потраченных килокалорий на тренировке (базовая реализация).
+func (t Training) Calories() float64 {
+	return 0 // Этот метод будет переопределен для каждого типа тренировки.
+}
+
+// InfoMessage содержит информацию о проведенной тренировке.
+type InfoMessage struct {
+	TrainingType string        // тип тренировки
+	Duration     time.Duration // длительность тренировки в минутах
+	Distance     float64       // расстояние в километрах
+	Speed        float64       // средняя скорость в км/ч
+	Calories     float64       // количество калорий
+	VolumeLoad   float64       // суммарный объем поднятого веса, кг (только для силовых тренировок)
+	Sets         int           // количество подходов (только для силовых тренировок)
+	Reps         int           // количество повторений в подходе (только для силовых тренировок)
+
+	HasHeartRateZones bool       // true, если пульсовые зоны были рассчитаны
+	HeartRateZones    [5]float64 // минуты, проведенные в каждой из 5 пульсовых зон
+
+	SwimmingDetail *SwimmingInfo // разбивка по стилям и SWOLF (только для плавания с заданными Laps)
+
+	HeightCm    float64 // рост пользователя, см (только для ходьбы)
+	PoolLengthM float64 // длина бассейна, м (только для плавания)
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о тренировке.
+func (t Training) TrainingInfo() InfoMessage {
+	return InfoMessage{
+		TrainingType: t.TrainingType,
+		Duration:     t.Duration,
+		Distance:     t.distance(),
+		Speed:        t.meanSpeed(),
+		Calories:     t.Calories(),
+	}
+}
+
+// String возвращает строку с информацией о проведенной тренировке в метрической системе единиц.
+// Чтобы отформатировать InfoMessage в другой системе единиц, используйте FormatWithUnits.
+func (i InfoMessage) String() string {
+	return i.FormatWithUnits(Metric)
+}
+
+// FormatWithUnits возвращает строку с информацией о проведенной тренировке,
+// отформатированную в системе единиц units (Metric или Imperial).
+func (i InfoMessage) FormatWithUnits(units Units) string {
+	distanceValue, distanceUnit := units.Distance(i.Distance)
+	speedValue, speedUnit := units.Speed(i.Speed)
+	paceValue, paceUnit := units.Pace(i.Speed)
+
+	distance := fmt.Sprintf("%.2f %s", distanceValue, distanceUnit)
+	speed := fmt.Sprintf("%.2f %s (темп %s %s)", speedValue, speedUnit, FormatPace(paceValue), paceUnit)
+	if i.Sets > 0 {
+		// У силовых тренировок нет дистанции/скорости — вместо них печатаем объем нагрузки.
+		distance, speed = "N/A", "N/A"
+	}
+
+	result := fmt.Sprintf("Тип тренировки: %s\nДлительность: %.2f мин\nДистанция: %s\nСр. скорость: %s\nПотрачено ккал: %.2f\n",
+		i.TrainingType, i.Duration.Minutes(), distance, speed, i.Calories)
+
+	if i.HeightCm > 0 {
+		heightValue, heightUnit := units.Height(i.HeightCm)
+		result += fmt.Sprintf("Рост: %.1f %s\n", heightValue, heightUnit)
+	}
+
+	if i.PoolLengthM > 0 {
+		poolValue, poolUnit := units.PoolLength(i.PoolLengthM)
+		result += fmt.Sprintf("Длина бассейна: %.1f %s\n", poolValue, poolUnit)
+	}
+
+	if i.Sets > 0 {
+		result += fmt.Sprintf("Объем нагрузки: %.1f кг (%d подходов x %d повторений)\n", i.VolumeLoad, i.Sets, i.Reps)
+	}
+
+	if i.HasHeartRateZones {
+		result += fmt.Sprintf("Пульсовые зоны (мин): 50-60%%: %.1f, 60-70%%: %.1f, 70-80%%: %.1f, 80-90%%: %.1f, 90-100%%: %.1f\n",
+			i.HeartRateZones[0], i.HeartRateZones[1], i.HeartRateZones[2], i.HeartRateZones[3], i.HeartRateZones[4])
+	}
+
+	if i.SwimmingDetail != nil {
+		result += fmt.Sprintf("SWOLF: %.1f, основной стиль: %s, дистанция по стилям: %v\n",
+			i.SwimmingDetail.AvgSWOLF, i.SwimmingDetail.MasterStroke, i.SwimmingDetail.StrokeDistances)
+	}
+
+	return result
+}
+
+// heartRateInfo считает пульсовые зоны для тренировки, если в ней есть показания пульса.
+func heartRateInfo(t Training) (zones [5]float64, ok bool) {
+	if len(t.HeartRate) < 2 {
+		return zones, false
+	}
+	calc := HeartRateZoneCalculator{Samples: t.HeartRate, Profile: t.Profile, Weight: t.Weight}
+	return calc.ZoneMinutes(), true
+}
+
+// CaloriesCalculator интерфейс для структур: Running, Walking, Swimming и Strength.
+type CaloriesCalculator interface {
+	Calories() float64
+	TrainingInfo() InfoMessage
+}
+
+// ReadData возвращает информацию о проведенной тренировке. По умолчанию используется
+// метрическая система единиц; чтобы вывести в другой системе, передайте ее явно:
+// ReadData(t, Imperial).
+func ReadData(training CaloriesCalculator, units ...Units) string {
+	u := Metric
+	if len(units) > 0 && units[0] != nil {
+		u = units[0]
+	}
+	return training.TrainingInfo().FormatWithUnits(u)
+}
@@ -0,0 +1,93 @@
+package training
+
+import (
+	"fmt"
+	"time"
+)
+
+// Units задает единицы измерения, в которых InfoMessage форматируется для вывода.
+// Сами значения InfoMessage всегда хранятся в метрической системе (км, км/ч, см, м);
+// Units отвечает только за то, как они печатаются и в каких единицах считается темп.
+type Units interface {
+	Name() string // название системы единиц для отладочного вывода
+	Distance(km float64) (value float64, unit string)
+	Speed(kmh float64) (value float64, unit string)
+	Height(cm float64) (value float64, unit string)
+	PoolLength(m float64) (value float64, unit string)
+	// Pace возвращает темп (время на единицу дистанции данной системы), посчитанный
+	// по переданной скорости в км/ч, и имя единицы темпа.
+	Pace(kmh float64) (pace time.Duration, unit string)
+}
+
+// Коэффициенты перевода между метрической и имперской системами.
+const (
+	KmToMi = 0.621371 // километры в мили
+	CmToIn = 0.393701 // сантиметры в дюймы
+	MToYd  = 1.09361  // метры в ярды
+)
+
+// metricUnits метрическая система единиц (км, км/ч, см, м) — используется по умолчанию.
+type metricUnits struct{}
+
+// Metric метрическая система единиц, используется по умолчанию везде, где Units не указан явно.
+var Metric Units = metricUnits{}
+
+func (metricUnits) Name() string { return "метрическая" }
+
+func (metricUnits) Distance(km float64) (float64, string) { return km, "км" }
+
+func (metricUnits) Speed(kmh float64) (float64, string) { return kmh, "км/ч" }
+
+func (metricUnits) Height(cm float64) (float64, string) { return cm, "см" }
+
+func (metricUnits) PoolLength(m float64) (float64, string) { return m, "м" }
+
+func (metricUnits) Pace(kmh float64) (time.Duration, string) {
+	return paceFromSpeed(kmh), "мин/км"
+}
+
+// imperialUnits имперская система единиц (мили, мили/ч, дюймы, ярды).
+type imperialUnits struct{}
+
+// Imperial имперская система единиц.
+var Imperial Units = imperialUnits{}
+
+func (imperialUnits) Name() string { return "имперская" }
+
+func (imperialUnits) Distance(km float64) (float64, string) { return km * KmToMi, "ми" }
+
+func (imperialUnits) Speed(kmh float64) (float64, string) { return kmh * KmToMi, "миль/ч" }
+
+func (imperialUnits) Height(cm float64) (float64, string) { return cm * CmToIn, "дюйм" }
+
+func (imperialUnits) PoolLength(m float64) (float64, string) { return m * MToYd, "ярд" }
+
+func (imperialUnits) Pace(kmh float64) (time.Duration, string) {
+	return paceFromSpeed(kmh * KmToMi), "мин/ми"
+}
+
+// paceFromSpeed переводит скорость (единиц дистанции в час) в темп — время на единицу дистанции.
+func paceFromSpeed(speed float64) time.Duration {
+	if speed <= 0 {
+		return 0
+	}
+	return time.Duration(float64(time.Hour) / speed)
+}
+
+// Pace возвращает темп тренировки (время на километр) в метрической системе.
+// Принимает CaloriesCalculator и считает темп по TrainingInfo().Speed, а не по
+// встроенному Training.meanSpeed(): из-за встраивания структур Go meanSpeed
+// не является виртуальным методом, и вызов training.meanSpeed() напрямую молча
+// возвращал бы скорость базового Training вместо Running/Walking/Swimming/Strength.
+// Чтобы получить темп в другой системе единиц, используйте u.Pace(training.TrainingInfo().Speed).
+func Pace(training CaloriesCalculator) time.Duration {
+	return paceFromSpeed(training.TrainingInfo().Speed)
+}
+
+// FormatPace форматирует темп как MM:SS, например 5*time.Minute+30*time.Second -> "05:30".
+func FormatPace(d time.Duration) string {
+	totalSeconds := int(d.Round(time.Second).Seconds())
+	minutes := totalSeconds / 60
+	seconds := totalSeconds % 60
+	return fmt.Sprintf("%02d:%02d", minutes, seconds)
+}
@@ -0,0 +1,64 @@
+package training
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestImperialConversions(t *testing.T) {
+	if v, unit := Imperial.Distance(10); unit != "ми" || math.Abs(v-6.21371) > 1e-4 {
+		t.Errorf("Imperial.Distance(10) = %v %s, want ~6.21371 ми", v, unit)
+	}
+	if v, unit := Imperial.Height(100); unit != "дюйм" || math.Abs(v-39.3701) > 1e-4 {
+		t.Errorf("Imperial.Height(100) = %v %s, want ~39.3701 дюйм", v, unit)
+	}
+	if v, unit := Imperial.PoolLength(25); unit != "ярд" || math.Abs(v-27.34025) > 1e-4 {
+		t.Errorf("Imperial.PoolLength(25) = %v %s, want ~27.34025 ярд", v, unit)
+	}
+}
+
+func TestMetricConversionsAreIdentity(t *testing.T) {
+	if v, unit := Metric.Distance(10); unit != "км" || v != 10 {
+		t.Errorf("Metric.Distance(10) = %v %s, want 10 км", v, unit)
+	}
+	if v, unit := Metric.Height(100); unit != "см" || v != 100 {
+		t.Errorf("Metric.Height(100) = %v %s, want 100 см", v, unit)
+	}
+}
+
+func TestPaceFromSpeed(t *testing.T) {
+	pace, unit := Metric.Pace(12)
+	if unit != "мин/км" {
+		t.Fatalf("unit = %q, want мин/км", unit)
+	}
+	if got := FormatPace(pace); got != "05:00" {
+		t.Errorf("FormatPace(12 км/ч) = %q, want 05:00", got)
+	}
+}
+
+func TestPaceFromZeroSpeed(t *testing.T) {
+	if pace, _ := Metric.Pace(0); pace != 0 {
+		t.Errorf("Pace(0) = %v, want 0", pace)
+	}
+}
+
+func TestPaceUsesConcreteMeanSpeed(t *testing.T) {
+	// Pace принимает CaloriesCalculator и должен считать темп по TrainingInfo().Speed
+	// конкретного типа, а не по Training.meanSpeed() встроенной структуры — иначе для
+	// Swimming и Strength, переопределяющих meanSpeed(), темп молча считался бы неверно.
+	sw := Swimming{
+		Training:   Training{Duration: time.Hour, Weight: 70},
+		LengthPool: 50,
+		CountPool:  1,
+	}
+	wantSwim := paceFromSpeed(sw.meanSpeed())
+	if got := Pace(sw); got != wantSwim {
+		t.Errorf("Pace(Swimming) = %v, want %v (meanSpeed of Swimming, not base Training)", got, wantSwim)
+	}
+
+	st := Strength{Training: Training{Duration: time.Hour, Weight: 70}, Sets: 3, Reps: 10, WeightLifted: 20}
+	if got := Pace(st); got != 0 {
+		t.Errorf("Pace(Strength) = %v, want 0 (Strength has no distance/speed)", got)
+	}
+}
@@ -0,0 +1,42 @@
+package training
+
+import (
+	"math"
+)
+
+// Walking структура, описывающая тренировку Ходьба.
+type Walking struct {
+	Training
+	Height float64 // рост пользователя в см
+}
+
+// Константы для расчета потраченных килокалорий при ходьбе.
+const (
+	CaloriesWeightMultiplier      = 0.035 // коэффициент для веса
+	CaloriesSpeedHeightMultiplier = 0.029 // коэффициент для роста
+	KmHInMsec                     = 0.278 // коэффициент для перевода км/ч в м/с
+)
+
+// Calories возвращает количество потраченных килокалорий при ходьбе.
+// При наличии показаний пульса используется формула Кейтела вместо формулы по скорости и росту.
+func (w Walking) Calories() float64 {
+	if kcal, ok := heartRateCalories(w.HeartRate, w.Profile, w.Weight); ok {
+		return kcal
+	}
+
+	speedInMps := w.meanSpeed() * KmHInMsec
+	return (CaloriesWeightMultiplier*w.Weight + (math.Pow(speedInMps, 2)/w.Height)*CaloriesSpeedHeightMultiplier*w.Weight) * w.Duration.Hours() * MinInHours
+}
+
+func (w Walking) TrainingInfo() InfoMessage {
+	info := InfoMessage{
+		TrainingType: w.TrainingType,
+		Duration:     w.Duration,
+		Distance:     w.distance(),
+		Speed:        w.meanSpeed(),
+		Calories:     w.Calories(), // Calls Walking's own Calories method
+		HeightCm:     w.Height,
+	}
+	info.HeartRateZones, info.HasHeartRateZones = heartRateInfo(w.Training)
+	return info
+}